@@ -75,6 +75,158 @@ resource "site24x7_monitor_group" "monitor_group" {
   description = "This is the description of the group"
 }
 
+// Location Profile API doc: https://www.site24x7.com/help/api/#location-profiles
+resource "site24x7_location_profile" "location_profile" {
+  // (Required) Display name for the location profile.
+  profile_name = "myprofile"
+
+  // (Required) Primary location for monitoring. See
+  // https://www.site24x7.com/help/api/#list-of-all-location-templates for
+  // available location IDs.
+  primary_location = "20"
+
+  // (Optional) List of secondary locations for monitoring.
+  secondary_locations = [
+    "21",
+    "22",
+  ]
+
+  // (Optional) Restrict alternate location polling to the locations of the
+  // same country as the primary location. Default: false.
+  restrict_alternate_location = true
+}
+
+// Notification Profile API doc: https://www.site24x7.com/help/api/#notification-profiles
+resource "site24x7_notification_profile" "notification_profile" {
+  // (Required) Display name for the notification profile.
+  profile_name = "myprofile"
+
+  // (Optional) Configuration to send root cause analysis when the monitor is
+  // down. Default: true.
+  rca_needed = true
+
+  // (Optional) Settings to send alerts only after executing the configured IT
+  // automations. Default: true.
+  notify_after_executing_actions = true
+
+  // (Optional) Delay the notification of a down status by the given number
+  // of polls. See
+  // https://www.site24x7.com/help/api/#notification-profiles for allowed
+  // values.
+  downtime_notification_delay = 2
+
+  // (Optional) Send persistent notifications on every Nth poll while the
+  // monitor stays down.
+  persistent_notification = 1
+
+  // (Optional) User group to be notified when the monitor is still down
+  // after escalation_wait_time minutes.
+  escalation_user_group_id = "123"
+
+  // (Optional) Minutes to wait before escalating a down alert.
+  escalation_wait_time = 30
+
+  // (Optional) List of IT automation IDs to execute on escalation.
+  escalation_automations = [
+    "${site24x7_action.action.id}",
+  ]
+
+  // (Optional) List of third party service IDs to notify on escalation.
+  escalation_services = [
+    "123",
+  ]
+
+  // (Optional) Email template to use for notifications. If omitted, the
+  // default template is used.
+  template_id = "123"
+}
+
+// Threshold Profile API doc: https://www.site24x7.com/help/api/#threshold-website
+resource "site24x7_threshold_profile" "threshold_profile" {
+  // (Required) Display name for the threshold profile.
+  profile_name = "myprofile"
+
+  // (Required) Monitor type the threshold profile applies to, e.g. "URL".
+  // Changing this forces a new threshold profile to be created.
+  type = "URL"
+
+  // (Optional) Number of locations that have to report the monitor down
+  // before it is declared down. Default: 1.
+  down_location_threshold = 2
+
+  // (Optional) Trigger an alert if the website content is modified. Only
+  // supported for type "URL". Default: false.
+  website_content_modified = false
+}
+
+// User Group API doc: https://www.site24x7.com/help/api/#user-groups
+resource "site24x7_user_group" "user_group" {
+  // (Required) Display name for the user group.
+  display_name = "mygroup"
+
+  // (Required) List of user IDs to be associated with the user group.
+  users = [
+    "123",
+  ]
+
+  // (Optional) Attribute alert group to be associated with the user group.
+  attribute_group_id = "123"
+}
+
+// Data sources look up existing objects by their exact name or by a regular
+// expression via name_regex. Exactly one object has to match.
+data "site24x7_location_profile" "europe" {
+  profile_name = "Europe"
+}
+
+data "site24x7_notification_profile" "default" {
+  name_regex = "^Default"
+}
+
+data "site24x7_threshold_profile" "website" {
+  profile_name = "Website Threshold"
+}
+
+data "site24x7_user_group" "ops" {
+  display_name = "Ops"
+}
+
+data "site24x7_monitor_group" "catalog" {
+  name_regex = "^catalog-"
+}
+
+// Look up a single monitor by id, display_name or name_regex. All attributes
+// of the site24x7_website_monitor resource are exported.
+data "site24x7_monitor" "checkout" {
+  display_name = "checkout-api"
+}
+
+// List monitors. All filters are optional and are combined.
+data "site24x7_monitors" "catalog" {
+  // (Optional) Only include monitors of the given type.
+  type = "URL"
+
+  // (Optional) Only include monitors that are part of the given monitor group.
+  monitor_group = "123"
+
+  // (Optional) Only include monitors that have the given tag assigned.
+  tag_id = "123"
+
+  // (Optional) Only include monitors whose display name matches the regular
+  // expression.
+  name_regex = "^catalog-"
+}
+
+// List the Site24x7 check locations and their IP addresses, e.g. to allowlist
+// synthetic monitoring traffic in firewalls. The IP addresses are resolved via
+// DNS, see
+// https://support.site24x7.com/portal/kb/articles/domain-configurations-for-location-server-ips
+data "site24x7_monitor_locations" "europe" {
+  // (Optional) Only include locations on the given continent. Case
+  // insensitive.
+  continent = "Europe"
+}
+
 // Website Monitor API doc: https://www.site24x7.com/help/api/#website
 resource "site24x7_website_monitor" "website_monitor" {
   // (Required) Name for the monitor.
@@ -0,0 +1,2 @@
+# Import location profile by ID
+terraform import site24x7_location_profile.profile 79730000012345678
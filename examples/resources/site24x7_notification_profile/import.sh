@@ -0,0 +1,2 @@
+# Import notification profile by ID
+terraform import site24x7_notification_profile.profile 79730000012345678
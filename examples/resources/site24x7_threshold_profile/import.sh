@@ -0,0 +1,2 @@
+# Import threshold profile by ID
+terraform import site24x7_threshold_profile.profile 79730000012345678
@@ -0,0 +1,2 @@
+# Import user group by ID
+terraform import site24x7_user_group.group 79730000012345678
@@ -0,0 +1,49 @@
+package site24x7
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// findByName returns the index of the only element of names that matches
+// either the exact name stored under nameKey or the regular expression stored
+// under name_regex in d. The kind is used to build error messages, e.g.
+// "location profile". findByName returns an error if none or more than one of
+// the names match.
+func findByName(d *schema.ResourceData, nameKey, kind string, names []string) (int, error) {
+	var match func(name string) bool
+	var criteria string
+
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		re, err := regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return -1, err
+		}
+
+		match = re.MatchString
+		criteria = fmt.Sprintf("matching name_regex %q", nameRegex)
+	} else {
+		exactName := d.Get(nameKey).(string)
+
+		match = func(name string) bool { return name == exactName }
+		criteria = fmt.Sprintf("with %s %q", nameKey, exactName)
+	}
+
+	var matches []int
+	for i, name := range names {
+		if match(name) {
+			matches = append(matches, i)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return -1, fmt.Errorf("no %s found %s", kind, criteria)
+	case 1:
+		return matches[0], nil
+	default:
+		return -1, fmt.Errorf("found %d %ss %s, expected exactly one", len(matches), kind, criteria)
+	}
+}
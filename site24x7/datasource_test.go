@@ -0,0 +1,77 @@
+package site24x7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindByName(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         map[string]interface{}
+		names       []string
+		expected    int
+		expectedErr error
+	}{
+		{
+			name:     "exact name match",
+			raw:      map[string]interface{}{"profile_name": "foo"},
+			names:    []string{"foobar", "foo", "bar"},
+			expected: 1,
+		},
+		{
+			name:     "regex match",
+			raw:      map[string]interface{}{"name_regex": "^ba"},
+			names:    []string{"foobar", "foo", "bar"},
+			expected: 2,
+		},
+		{
+			name:        "no exact name match",
+			raw:         map[string]interface{}{"profile_name": "baz"},
+			names:       []string{"foobar", "foo", "bar"},
+			expected:    -1,
+			expectedErr: errors.New(`no location profile found with profile_name "baz"`),
+		},
+		{
+			name:        "no regex match",
+			raw:         map[string]interface{}{"name_regex": "^baz"},
+			names:       []string{"foobar", "foo", "bar"},
+			expected:    -1,
+			expectedErr: errors.New(`no location profile found matching name_regex "^baz"`),
+		},
+		{
+			name:        "multiple exact name matches",
+			raw:         map[string]interface{}{"profile_name": "foo"},
+			names:       []string{"foo", "bar", "foo"},
+			expected:    -1,
+			expectedErr: errors.New(`found 2 location profiles with profile_name "foo", expected exactly one`),
+		},
+		{
+			name:        "multiple regex matches",
+			raw:         map[string]interface{}{"name_regex": "^foo"},
+			names:       []string{"foobar", "foo", "bar"},
+			expected:    -1,
+			expectedErr: errors.New(`found 2 location profiles matching name_regex "^foo", expected exactly one`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, LocationProfileDataSourceSchema, test.raw)
+
+			i, err := findByName(d, "profile_name", "location profile", test.names)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected, i)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package site24x7
+
+import (
+	"sort"
+
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var LocationProfileSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"primary_location": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"secondary_locations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
+	},
+	"restrict_alternate_location": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
+func resourceSite24x7LocationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: locationProfileCreate,
+		Read:   locationProfileRead,
+		Update: locationProfileUpdate,
+		Delete: locationProfileDelete,
+		Exists: locationProfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: LocationProfileSchema,
+	}
+}
+
+func locationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	locationProfile := resourceDataToLocationProfile(d)
+
+	locationProfile, err := client.LocationProfiles().Create(locationProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(locationProfile.ProfileID)
+
+	return nil
+}
+
+func locationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	locationProfile, err := client.LocationProfiles().Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateLocationProfileResourceData(d, locationProfile)
+
+	return nil
+}
+
+func locationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	locationProfile := resourceDataToLocationProfile(d)
+
+	locationProfile, err := client.LocationProfiles().Update(locationProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(locationProfile.ProfileID)
+
+	return nil
+}
+
+func locationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	err := client.LocationProfiles().Delete(d.Id())
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func locationProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(site24x7.Client)
+
+	_, err := client.LocationProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func resourceDataToLocationProfile(d *schema.ResourceData) *api.LocationProfile {
+	var secondaryLocations []string
+	for _, location := range d.Get("secondary_locations").(*schema.Set).List() {
+		secondaryLocations = append(secondaryLocations, location.(string))
+	}
+
+	sort.Strings(secondaryLocations)
+
+	return &api.LocationProfile{
+		ProfileID:          d.Id(),
+		ProfileName:        d.Get("profile_name").(string),
+		PrimaryLocation:    d.Get("primary_location").(string),
+		SecondaryLocations: secondaryLocations,
+		RestrictAltLoc:     d.Get("restrict_alternate_location").(bool),
+	}
+}
+
+func updateLocationProfileResourceData(d *schema.ResourceData, locationProfile *api.LocationProfile) {
+	d.Set("profile_name", locationProfile.ProfileName)                   //nolint:errcheck
+	d.Set("primary_location", locationProfile.PrimaryLocation)           //nolint:errcheck
+	d.Set("secondary_locations", locationProfile.SecondaryLocations)     //nolint:errcheck
+	d.Set("restrict_alternate_location", locationProfile.RestrictAltLoc) //nolint:errcheck
+}
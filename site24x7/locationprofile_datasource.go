@@ -0,0 +1,70 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var LocationProfileDataSourceSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"primary_location": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"secondary_locations": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"restrict_alternate_location": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7LocationProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: locationProfileDataSourceRead,
+
+		Schema: LocationProfileDataSourceSchema,
+	}
+}
+
+func locationProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	locationProfiles, err := client.LocationProfiles().List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(locationProfiles))
+	for i, locationProfile := range locationProfiles {
+		names[i] = locationProfile.ProfileName
+	}
+
+	i, err := findByName(d, "profile_name", "location profile", names)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(locationProfiles[i].ProfileID)
+
+	updateLocationProfileResourceData(d, locationProfiles[i])
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationProfileDataSourceRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, LocationProfileDataSourceSchema, map[string]interface{}{
+		"name_regex": "^foo",
+	})
+
+	c := fake.NewClient()
+
+	c.FakeLocationProfiles.On("List").Return([]*api.LocationProfile{
+		{
+			ProfileID:       "123",
+			ProfileName:     "bar",
+			PrimaryLocation: "20",
+		},
+		{
+			ProfileID:          "456",
+			ProfileName:        "foobar",
+			PrimaryLocation:    "30",
+			SecondaryLocations: []string{"31"},
+			RestrictAltLoc:     true,
+		},
+	}, nil).Once()
+
+	require.NoError(t, locationProfileDataSourceRead(d, c))
+
+	assert.Equal(t, "456", d.Id())
+	assert.Equal(t, "foobar", d.Get("profile_name"))
+	assert.Equal(t, "30", d.Get("primary_location"))
+	assert.Equal(t, []interface{}{"31"}, d.Get("secondary_locations").(*schema.Set).List())
+	assert.True(t, d.Get("restrict_alternate_location").(bool))
+
+	c.FakeLocationProfiles.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := locationProfileDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
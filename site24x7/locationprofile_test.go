@@ -0,0 +1,139 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocationProfileCreate(t *testing.T) {
+	d := locationProfileTestResourceData(t)
+
+	c := fake.NewClient()
+
+	a := &api.LocationProfile{
+		ProfileName:        "foobar",
+		PrimaryLocation:    "20",
+		SecondaryLocations: []string{"21", "22"},
+		RestrictAltLoc:     true,
+	}
+
+	c.FakeLocationProfiles.On("Create", a).Return(a, nil).Once()
+
+	require.NoError(t, locationProfileCreate(d, c))
+
+	c.FakeLocationProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := locationProfileCreate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestLocationProfileUpdate(t *testing.T) {
+	d := locationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	a := &api.LocationProfile{
+		ProfileID:          "123",
+		ProfileName:        "foobar",
+		PrimaryLocation:    "20",
+		SecondaryLocations: []string{"21", "22"},
+		RestrictAltLoc:     true,
+	}
+
+	c.FakeLocationProfiles.On("Update", a).Return(a, nil).Once()
+
+	require.NoError(t, locationProfileUpdate(d, c))
+
+	c.FakeLocationProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := locationProfileUpdate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestLocationProfileRead(t *testing.T) {
+	d := locationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{
+		ProfileID:          "123",
+		ProfileName:        "baz",
+		PrimaryLocation:    "30",
+		SecondaryLocations: []string{"31"},
+	}, nil).Once()
+
+	require.NoError(t, locationProfileRead(d, c))
+
+	assert.Equal(t, "baz", d.Get("profile_name"))
+	assert.Equal(t, "30", d.Get("primary_location"))
+	assert.Equal(t, []interface{}{"31"}, d.Get("secondary_locations").(*schema.Set).List())
+	assert.False(t, d.Get("restrict_alternate_location").(bool))
+
+	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := locationProfileRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestLocationProfileDelete(t *testing.T) {
+	d := locationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeLocationProfiles.On("Delete", "123").Return(nil).Once()
+
+	require.NoError(t, locationProfileDelete(d, c))
+
+	c.FakeLocationProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
+
+	require.NoError(t, locationProfileDelete(d, c))
+}
+
+func TestLocationProfileExists(t *testing.T) {
+	d := locationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeLocationProfiles.On("Get", "123").Return(&api.LocationProfile{}, nil).Once()
+
+	exists, err := locationProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
+
+	exists, err = locationProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	c.FakeLocationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	exists, err = locationProfileExists(d, c)
+
+	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.False(t, exists)
+}
+
+func locationProfileTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, LocationProfileSchema, map[string]interface{}{
+		"profile_name":                "foobar",
+		"primary_location":            "20",
+		"secondary_locations":         []interface{}{"22", "21"},
+		"restrict_alternate_location": true,
+	})
+}
@@ -0,0 +1,100 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var MonitorDataSourceSchema = monitorDataSourceSchema()
+
+// monitorDataSourceSchema derives the monitor data source schema from
+// WebsiteMonitorSchema by marking all attributes as computed and adding the
+// attributes used to look up the monitor.
+func monitorDataSourceSchema() map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(WebsiteMonitorSchema)+3)
+
+	for k, v := range WebsiteMonitorSchema {
+		s[k] = &schema.Schema{
+			Type:     v.Type,
+			Elem:     v.Elem,
+			Computed: true,
+		}
+	}
+
+	s["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "display_name", "name_regex"},
+	}
+	s["display_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", "display_name", "name_regex"},
+	}
+	s["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"id", "display_name", "name_regex"},
+	}
+	s["type"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	// Monitors may be owned by other teams, so make sure their credentials do
+	// not show up in plan output.
+	s["auth_pass"].Sensitive = true
+
+	return s
+}
+
+func dataSourceSite24x7Monitor() *schema.Resource {
+	return &schema.Resource{
+		Read: monitorDataSourceRead,
+
+		Schema: MonitorDataSourceSchema,
+	}
+}
+
+func monitorDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	var monitor *api.Monitor
+
+	if id, ok := d.GetOk("id"); ok {
+		var err error
+
+		monitor, err = client.Monitors().Get(id.(string))
+		if err != nil {
+			return err
+		}
+	} else {
+		monitors, err := client.Monitors().List()
+		if err != nil {
+			return err
+		}
+
+		names := make([]string, len(monitors))
+		for i, monitor := range monitors {
+			names[i] = monitor.DisplayName
+		}
+
+		i, err := findByName(d, "display_name", "monitor", names)
+		if err != nil {
+			return err
+		}
+
+		monitor = monitors[i]
+	}
+
+	d.SetId(monitor.MonitorID)
+
+	updateWebsiteMonitorResourceData(d, monitor)
+
+	return nil
+}
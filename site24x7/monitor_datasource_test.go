@@ -0,0 +1,105 @@
+package site24x7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorDataSourceSchema(t *testing.T) {
+	assert.True(t, MonitorDataSourceSchema["auth_pass"].Sensitive)
+	assert.False(t, WebsiteMonitorSchema["auth_pass"].Sensitive)
+}
+
+func TestMonitorDataSourceRead(t *testing.T) {
+	monitor := &api.Monitor{
+		MonitorID:         "123",
+		DisplayName:       "foo",
+		Type:              "URL",
+		Website:           "www.test.tld",
+		CheckFrequency:    "5",
+		LocationProfileID: "456",
+		MonitorGroups:     []string{"789"},
+		ActionIDs: []api.ActionRef{
+			{ActionID: "012", AlertType: 1},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(t *testing.T, c *fake.Client)
+		raw         map[string]interface{}
+		expectedErr error
+	}{
+		{
+			name: "lookup by id",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeMonitors.On("Get", "123").Return(monitor, nil).Once()
+			},
+			raw: map[string]interface{}{"id": "123"},
+		},
+		{
+			name: "lookup by display name",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeMonitors.On("List").Return([]*api.Monitor{{MonitorID: "234", DisplayName: "bar"}, monitor}, nil).Once()
+			},
+			raw: map[string]interface{}{"display_name": "foo"},
+		},
+		{
+			name: "lookup by name regex",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeMonitors.On("List").Return([]*api.Monitor{{MonitorID: "234", DisplayName: "bar"}, monitor}, nil).Once()
+			},
+			raw: map[string]interface{}{"name_regex": "^f"},
+		},
+		{
+			name: "no monitor with display name",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeMonitors.On("List").Return([]*api.Monitor{{MonitorID: "234", DisplayName: "bar"}}, nil).Once()
+			},
+			raw:         map[string]interface{}{"display_name": "foo"},
+			expectedErr: errors.New(`no monitor found with display_name "foo"`),
+		},
+		{
+			name: "passes through get monitor error",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeMonitors.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
+			},
+			raw:         map[string]interface{}{"id": "123"},
+			expectedErr: apierrors.NewStatusError(404, "not found"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fake.NewClient()
+			d := schema.TestResourceDataRaw(t, MonitorDataSourceSchema, test.raw)
+
+			test.setup(t, c)
+
+			err := monitorDataSourceRead(d, c)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, "123", d.Id())
+			assert.Equal(t, "foo", d.Get("display_name"))
+			assert.Equal(t, "URL", d.Get("type"))
+			assert.Equal(t, "www.test.tld", d.Get("website"))
+			assert.Equal(t, 5, d.Get("check_frequency"))
+			assert.Equal(t, "456", d.Get("location_profile_id"))
+			assert.Equal(t, []interface{}{"789"}, d.Get("monitor_groups"))
+			assert.Equal(t, map[string]interface{}{"1": "012"}, d.Get("actions"))
+		})
+	}
+}
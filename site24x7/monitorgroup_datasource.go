@@ -0,0 +1,59 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var MonitorGroupDataSourceSchema = map[string]*schema.Schema{
+	"display_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"display_name", "name_regex"},
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"display_name", "name_regex"},
+	},
+	"description": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7MonitorGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: monitorGroupDataSourceRead,
+
+		Schema: MonitorGroupDataSourceSchema,
+	}
+}
+
+func monitorGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	monitorGroups, err := client.MonitorGroups().List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(monitorGroups))
+	for i, monitorGroup := range monitorGroups {
+		names[i] = monitorGroup.DisplayName
+	}
+
+	i, err := findByName(d, "display_name", "monitor group", names)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(monitorGroups[i].GroupID)
+
+	updateMonitorGroupResourceData(d, monitorGroups[i])
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorGroupDataSourceRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, MonitorGroupDataSourceSchema, map[string]interface{}{
+		"name_regex": "^prod-",
+	})
+
+	c := fake.NewClient()
+
+	c.FakeMonitorGroups.On("List").Return([]*api.MonitorGroup{
+		{
+			GroupID:     "123",
+			DisplayName: "dev-catalog",
+		},
+		{
+			GroupID:     "456",
+			DisplayName: "prod-catalog",
+			Description: "baz",
+		},
+	}, nil).Once()
+
+	require.NoError(t, monitorGroupDataSourceRead(d, c))
+
+	assert.Equal(t, "456", d.Id())
+	assert.Equal(t, "prod-catalog", d.Get("display_name"))
+	assert.Equal(t, "baz", d.Get("description"))
+
+	c.FakeMonitorGroups.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := monitorGroupDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
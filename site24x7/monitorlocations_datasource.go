@@ -0,0 +1,219 @@
+package site24x7
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	"github.com/Bonial-International-GmbH/site24x7-go/location"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	log "github.com/sirupsen/logrus"
+)
+
+var MonitorLocationsDataSourceSchema = map[string]*schema.Schema{
+	"continent": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"locations": {
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"location_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"city": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"country": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"continent": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ipv4_addresses": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Computed: true,
+				},
+				"ipv6_addresses": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Computed: true,
+				},
+			},
+		},
+		Computed: true,
+	},
+	"ipv4_addresses": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"ipv6_addresses": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7MonitorLocations() *schema.Resource {
+	return &schema.Resource{
+		Read: monitorLocationsDataSourceRead,
+
+		Schema: MonitorLocationsDataSourceSchema,
+	}
+}
+
+func monitorLocationsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	return readMonitorLocations(d, client, location.NewDefaultDNSIPSource())
+}
+
+// readMonitorLocations populates d with all Site24x7 check locations that
+// match the configured continent. The IP addresses of each location are
+// looked up via ipSource.
+func readMonitorLocations(d *schema.ResourceData, client site24x7.Client, ipSource location.IPSource) error {
+	locationTemplate, err := client.LocationTemplate().Get()
+	if err != nil {
+		return err
+	}
+
+	continent := d.Get("continent").(string)
+
+	var locationIDs, allIPv4s, allIPv6s []string
+	var resolved int
+
+	locations := make([]interface{}, 0, len(locationTemplate.Locations))
+
+	for _, loc := range locationTemplate.Locations {
+		if continent != "" && !strings.EqualFold(loc.Continent, continent) {
+			continue
+		}
+
+		// Not every location has resolvable check IPs, e.g. if the display
+		// name does not contain a country code. Those are kept with empty
+		// address lists. Any other lookup error, e.g. a DNS timeout, fails the
+		// data source so that firewall rules fed by it are not emptied.
+		ips, err := ipSource.LookupIPs(loc)
+		switch {
+		case err == nil:
+			resolved++
+		case isUnresolvableLocation(loc, err):
+			log.Warnf("failed to look up IPs for location %q: %v", loc.DisplayName, err)
+		default:
+			return err
+		}
+
+		ipv4s, ipv6s := splitIPsByVersion(ips)
+
+		locationIDs = append(locationIDs, loc.LocationID)
+		allIPv4s = append(allIPv4s, ipv4s...)
+		allIPv6s = append(allIPv6s, ipv6s...)
+
+		locations = append(locations, map[string]interface{}{
+			"location_id":    loc.LocationID,
+			"display_name":   loc.DisplayName,
+			"city":           loc.CityName,
+			"country":        loc.CountryName,
+			"continent":      loc.Continent,
+			"ipv4_addresses": ipv4s,
+			"ipv6_addresses": ipv6s,
+		})
+	}
+
+	if len(locations) > 0 && resolved == 0 {
+		return fmt.Errorf("failed to look up IPs for all %d selected locations", len(locations))
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(locationIDs, ","))))
+	d.Set("locations", locations)                          //nolint:errcheck
+	d.Set("ipv4_addresses", sortedUniqueStrings(allIPv4s)) //nolint:errcheck
+	d.Set("ipv6_addresses", sortedUniqueStrings(allIPv6s)) //nolint:errcheck
+
+	return nil
+}
+
+// isUnresolvableLocation returns true if err indicates that the IPs of loc
+// can never be resolved. This is the case if the display name does not have
+// the "City - CC" form or if no DNS record exists for the location. All other
+// errors are considered transient.
+func isUnresolvableLocation(loc *api.Location, err error) bool {
+	parts := strings.Split(loc.DisplayName, " - ")
+	if len(parts) != 2 || parts[1] == "" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsNotFound
+	}
+
+	// location.DNSIPSource does not wrap the *net.DNSError it receives, so
+	// fall back to matching the message of a not found error.
+	return strings.HasSuffix(err.Error(), "no such host")
+}
+
+// splitIPsByVersion splits ips into sorted, de-duplicated lists of IPv4 and
+// IPv6 addresses. Values that are not valid IP addresses are dropped. Sorting
+// keeps the result stable if DNS returns the records in a different order.
+func splitIPsByVersion(ips []string) (ipv4s []string, ipv6s []string) {
+	ipv4s, ipv6s = []string{}, []string{}
+
+	for _, ip := range ips {
+		parsed := net.ParseIP(ip)
+
+		switch {
+		case parsed == nil:
+			continue
+		case parsed.To4() != nil:
+			ipv4s = append(ipv4s, ip)
+		default:
+			ipv6s = append(ipv6s, ip)
+		}
+	}
+
+	return sortedUniqueStrings(ipv4s), sortedUniqueStrings(ipv6s)
+}
+
+// sortedUniqueStrings returns a sorted copy of values with duplicates removed.
+func sortedUniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+
+		seen[v] = true
+		result = append(result, v)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,245 @@
+package site24x7
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/Bonial-International-GmbH/site24x7-go/location"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingIPSource returns the error configured in errs for a location and
+// delegates to the embedded IPSource otherwise.
+type failingIPSource struct {
+	location.IPSource
+	errs map[string]error
+}
+
+func (s *failingIPSource) LookupIPs(location *api.Location) ([]string, error) {
+	if err, ok := s.errs[location.LocationID]; ok {
+		return nil, err
+	}
+
+	return s.IPSource.LookupIPs(location)
+}
+
+func TestReadMonitorLocations(t *testing.T) {
+	locationTemplate := &api.LocationTemplate{
+		Locations: []*api.Location{
+			{
+				LocationID:  "1",
+				DisplayName: "Frankfurt - DE",
+				CityName:    "Frankfurt",
+				CountryName: "Germany",
+				Continent:   "Europe",
+			},
+			{
+				LocationID:  "2",
+				DisplayName: "Dallas - US",
+				CityName:    "Dallas",
+				CountryName: "United States",
+				Continent:   "North America",
+			},
+			{
+				LocationID:  "3",
+				DisplayName: "London",
+				CityName:    "London",
+				CountryName: "United Kingdom",
+				Continent:   "Europe",
+			},
+		},
+	}
+
+	ipSource := &failingIPSource{
+		IPSource: &location.StaticIPSource{
+			LocationIPs: map[string][]string{
+				"1": {"5.6.7.9", "2001:db8::2", "1.2.3.4", "2001:db8::1", "5.6.7.9"},
+				"2": {"5.6.7.8", "invalid", "1.2.3.4"},
+			},
+		},
+		errs: map[string]error{
+			"3": errors.New(`failed to parse country code from location name "London"`),
+		},
+	}
+
+	frankfurt := map[string]interface{}{
+		"location_id":    "1",
+		"display_name":   "Frankfurt - DE",
+		"city":           "Frankfurt",
+		"country":        "Germany",
+		"continent":      "Europe",
+		"ipv4_addresses": []interface{}{"1.2.3.4", "5.6.7.9"},
+		"ipv6_addresses": []interface{}{"2001:db8::1", "2001:db8::2"},
+	}
+
+	dallas := map[string]interface{}{
+		"location_id":    "2",
+		"display_name":   "Dallas - US",
+		"city":           "Dallas",
+		"country":        "United States",
+		"continent":      "North America",
+		"ipv4_addresses": []interface{}{"1.2.3.4", "5.6.7.8"},
+		"ipv6_addresses": []interface{}{},
+	}
+
+	london := map[string]interface{}{
+		"location_id":    "3",
+		"display_name":   "London",
+		"city":           "London",
+		"country":        "United Kingdom",
+		"continent":      "Europe",
+		"ipv4_addresses": []interface{}{},
+		"ipv6_addresses": []interface{}{},
+	}
+
+	tests := []struct {
+		name              string
+		raw               map[string]interface{}
+		expectedLocations []interface{}
+		expectedIPv4s     []interface{}
+		expectedIPv6s     []interface{}
+	}{
+		{
+			name:              "all locations",
+			raw:               map[string]interface{}{},
+			expectedLocations: []interface{}{frankfurt, dallas, london},
+			expectedIPv4s:     []interface{}{"1.2.3.4", "5.6.7.8", "5.6.7.9"},
+			expectedIPv6s:     []interface{}{"2001:db8::1", "2001:db8::2"},
+		},
+		{
+			name:              "filter by continent",
+			raw:               map[string]interface{}{"continent": "north america"},
+			expectedLocations: []interface{}{dallas},
+			expectedIPv4s:     []interface{}{"1.2.3.4", "5.6.7.8"},
+			expectedIPv6s:     []interface{}{},
+		},
+		{
+			name:              "keeps locations whose IPs cannot be resolved with empty address lists",
+			raw:               map[string]interface{}{"continent": "Europe"},
+			expectedLocations: []interface{}{frankfurt, london},
+			expectedIPv4s:     []interface{}{"1.2.3.4", "5.6.7.9"},
+			expectedIPv6s:     []interface{}{"2001:db8::1", "2001:db8::2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fake.NewClient()
+			d := schema.TestResourceDataRaw(t, MonitorLocationsDataSourceSchema, test.raw)
+
+			c.FakeLocationTemplate.On("Get").Return(locationTemplate, nil).Once()
+
+			require.NoError(t, readMonitorLocations(d, c, ipSource))
+
+			assert.Equal(t, test.expectedLocations, d.Get("locations"))
+			assert.Equal(t, test.expectedIPv4s, d.Get("ipv4_addresses"))
+			assert.Equal(t, test.expectedIPv6s, d.Get("ipv6_addresses"))
+		})
+	}
+}
+
+func TestReadMonitorLocationsLookupErrors(t *testing.T) {
+	rioDeJaneiro := &api.Location{LocationID: "1", DisplayName: "Rio de Janeiro - BR"}
+	fooBarBaz := &api.Location{LocationID: "2", DisplayName: "Foo Bar Baz - DE"}
+	frankfurt := &api.Location{LocationID: "3", DisplayName: "Frankfurt"}
+
+	tests := []struct {
+		name        string
+		locations   []*api.Location
+		errs        map[string]error
+		expectedErr error
+	}{
+		{
+			name:      "keeps locations without country code",
+			locations: []*api.Location{rioDeJaneiro, frankfurt},
+			errs: map[string]error{
+				"3": errors.New(`failed to parse country code from location name "Frankfurt"`),
+			},
+		},
+		{
+			name:      "keeps locations without dns record",
+			locations: []*api.Location{rioDeJaneiro, fooBarBaz},
+			errs: map[string]error{
+				"2": errors.New(`failed to lookup IPs for location "Foo Bar Baz - DE": lookup foo-de.enduserexp.com: no such host`),
+			},
+		},
+		{
+			name:      "keeps locations with unwrapped not found dns error",
+			locations: []*api.Location{rioDeJaneiro, fooBarBaz},
+			errs: map[string]error{
+				"2": &net.DNSError{Err: "no such host", Name: "foo-de.enduserexp.com", IsNotFound: true},
+			},
+		},
+		{
+			name:      "fails on transient dns error",
+			locations: []*api.Location{rioDeJaneiro, fooBarBaz},
+			errs: map[string]error{
+				"2": errors.New(`failed to lookup IPs for location "Foo Bar Baz - DE": lookup foo-de.enduserexp.com: i/o timeout`),
+			},
+			expectedErr: errors.New(`failed to lookup IPs for location "Foo Bar Baz - DE": lookup foo-de.enduserexp.com: i/o timeout`),
+		},
+		{
+			name:      "fails on unwrapped transient dns error",
+			locations: []*api.Location{rioDeJaneiro, fooBarBaz},
+			errs: map[string]error{
+				"2": &net.DNSError{Err: "server misbehaving", Name: "foo-de.enduserexp.com", IsTemporary: true},
+			},
+			expectedErr: errors.New("lookup foo-de.enduserexp.com: server misbehaving"),
+		},
+		{
+			name:      "fails if no location resolves",
+			locations: []*api.Location{fooBarBaz, frankfurt},
+			errs: map[string]error{
+				"2": errors.New(`failed to lookup IPs for location "Foo Bar Baz - DE": lookup foo-de.enduserexp.com: no such host`),
+				"3": errors.New(`failed to parse country code from location name "Frankfurt"`),
+			},
+			expectedErr: errors.New("failed to look up IPs for all 2 selected locations"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fake.NewClient()
+			d := schema.TestResourceDataRaw(t, MonitorLocationsDataSourceSchema, map[string]interface{}{})
+
+			c.FakeLocationTemplate.On("Get").Return(&api.LocationTemplate{Locations: test.locations}, nil).Once()
+
+			ipSource := &failingIPSource{
+				IPSource: &location.StaticIPSource{
+					LocationIPs: map[string][]string{
+						"1": {"1.2.3.4"},
+					},
+				},
+				errs: test.errs,
+			}
+
+			err := readMonitorLocations(d, c, ipSource)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, d.Get("locations"), len(test.locations))
+			assert.Equal(t, []interface{}{"1.2.3.4"}, d.Get("ipv4_addresses"))
+		})
+	}
+}
+
+func TestReadMonitorLocationsTemplateError(t *testing.T) {
+	c := fake.NewClient()
+	d := schema.TestResourceDataRaw(t, MonitorLocationsDataSourceSchema, map[string]interface{}{})
+
+	c.FakeLocationTemplate.On("Get").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := readMonitorLocations(d, c, &location.StaticIPSource{})
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
@@ -0,0 +1,161 @@
+package site24x7
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var MonitorsDataSourceSchema = map[string]*schema.Schema{
+	"type": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"monitor_group": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"tag_id": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	},
+	"ids": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"monitors": {
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7Monitors() *schema.Resource {
+	return &schema.Resource{
+		Read: monitorsDataSourceRead,
+
+		Schema: MonitorsDataSourceSchema,
+	}
+}
+
+func monitorsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	monitors, err := client.Monitors().List()
+	if err != nil {
+		return err
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	var taggedMonitorIDs map[string]bool
+	if tagID, ok := d.GetOk("tag_id"); ok {
+		taggedMonitorIDs, err = monitorIDsWithTag(client, tagID.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	monitorType := d.Get("type").(string)
+	monitorGroup := d.Get("monitor_group").(string)
+
+	ids := make([]string, 0, len(monitors))
+	matches := make([]interface{}, 0, len(monitors))
+
+	for _, monitor := range monitors {
+		if monitorType != "" && monitor.Type != monitorType {
+			continue
+		}
+
+		if monitorGroup != "" && !containsString(monitor.MonitorGroups, monitorGroup) {
+			continue
+		}
+
+		if taggedMonitorIDs != nil && !taggedMonitorIDs[monitor.MonitorID] {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(monitor.DisplayName) {
+			continue
+		}
+
+		ids = append(ids, monitor.MonitorID)
+		matches = append(matches, map[string]interface{}{
+			"id":           monitor.MonitorID,
+			"display_name": monitor.DisplayName,
+			"type":         monitor.Type,
+		})
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	d.Set("ids", ids)          //nolint:errcheck
+	d.Set("monitors", matches) //nolint:errcheck
+
+	return nil
+}
+
+// monitorIDsWithTag returns the set of IDs of all monitors that have the tag
+// with tagID assigned. The api.Monitor type does not carry tags, so these are
+// obtained from the current status endpoint.
+func monitorIDsWithTag(client site24x7.Client, tagID string) (map[string]bool, error) {
+	status, err := client.CurrentStatus().List(&api.CurrentStatusListOptions{
+		GroupRequired: api.Bool(false),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	monitorIDs := make(map[string]bool)
+	for _, monitorStatus := range status.Monitors {
+		if containsString(monitorStatus.Tags, tagID) {
+			monitorIDs[monitorStatus.MonitorID] = true
+		}
+	}
+
+	return monitorIDs, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,139 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorsDataSourceRead(t *testing.T) {
+	monitors := []*api.Monitor{
+		{
+			MonitorID:     "1",
+			DisplayName:   "catalog-api",
+			Type:          "URL",
+			MonitorGroups: []string{"g1"},
+		},
+		{
+			MonitorID:     "2",
+			DisplayName:   "catalog-web",
+			Type:          "HOMEPAGE",
+			MonitorGroups: []string{"g1", "g2"},
+		},
+		{
+			MonitorID:   "3",
+			DisplayName: "checkout-api",
+			Type:        "URL",
+		},
+	}
+
+	tests := []struct {
+		name        string
+		setup       func(t *testing.T, c *fake.Client)
+		raw         map[string]interface{}
+		expectedIDs []interface{}
+		expectedErr error
+	}{
+		{
+			name:        "no filters",
+			raw:         map[string]interface{}{},
+			expectedIDs: []interface{}{"1", "2", "3"},
+		},
+		{
+			name:        "filter by type",
+			raw:         map[string]interface{}{"type": "URL"},
+			expectedIDs: []interface{}{"1", "3"},
+		},
+		{
+			name:        "filter by monitor group",
+			raw:         map[string]interface{}{"monitor_group": "g2"},
+			expectedIDs: []interface{}{"2"},
+		},
+		{
+			name:        "filter by name regex",
+			raw:         map[string]interface{}{"name_regex": "-api$"},
+			expectedIDs: []interface{}{"1", "3"},
+		},
+		{
+			name: "filter by tag",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeCurrentStatus.On("List", &api.CurrentStatusListOptions{GroupRequired: api.Bool(false)}).Return(&api.MonitorsStatus{
+					Monitors: []*api.MonitorStatus{
+						{MonitorID: "1", Tags: []string{"t1", "t2"}},
+						{MonitorID: "2", Tags: []string{"t2"}},
+						{MonitorID: "3"},
+					},
+				}, nil).Once()
+			},
+			raw:         map[string]interface{}{"tag_id": "t1"},
+			expectedIDs: []interface{}{"1"},
+		},
+		{
+			name: "combined filters",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeCurrentStatus.On("List", &api.CurrentStatusListOptions{GroupRequired: api.Bool(false)}).Return(&api.MonitorsStatus{
+					Monitors: []*api.MonitorStatus{
+						{MonitorID: "1", Tags: []string{"t2"}},
+						{MonitorID: "2", Tags: []string{"t2"}},
+						{MonitorID: "3", Tags: []string{"t2"}},
+					},
+				}, nil).Once()
+			},
+			raw: map[string]interface{}{
+				"type":          "URL",
+				"monitor_group": "g1",
+				"tag_id":        "t2",
+				"name_regex":    "^catalog",
+			},
+			expectedIDs: []interface{}{"1"},
+		},
+		{
+			name: "passes through current status error",
+			setup: func(t *testing.T, c *fake.Client) {
+				c.FakeCurrentStatus.On("List", &api.CurrentStatusListOptions{GroupRequired: api.Bool(false)}).Return(nil, apierrors.NewStatusError(500, "error")).Once()
+			},
+			raw:         map[string]interface{}{"tag_id": "t1"},
+			expectedErr: apierrors.NewStatusError(500, "error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := fake.NewClient()
+			d := schema.TestResourceDataRaw(t, MonitorsDataSourceSchema, test.raw)
+
+			c.FakeMonitors.On("List").Return(monitors, nil).Once()
+
+			if test.setup != nil {
+				test.setup(t, c)
+			}
+
+			err := monitorsDataSourceRead(d, c)
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, test.expectedErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedIDs, d.Get("ids"))
+			assert.Len(t, d.Get("monitors"), len(test.expectedIDs))
+		})
+	}
+}
+
+func TestMonitorsDataSourceReadListError(t *testing.T) {
+	c := fake.NewClient()
+	d := schema.TestResourceDataRaw(t, MonitorsDataSourceSchema, map[string]interface{}{})
+
+	c.FakeMonitors.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := monitorsDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
@@ -0,0 +1,184 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var NotificationProfileSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"rca_needed": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	"notify_after_executing_actions": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  true,
+	},
+	"downtime_notification_delay": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"persistent_notification": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"escalation_user_group_id": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"escalation_wait_time": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"escalation_automations": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
+	},
+	"escalation_services": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Optional: true,
+	},
+	"template_id": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	},
+}
+
+func resourceSite24x7NotificationProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: notificationProfileCreate,
+		Read:   notificationProfileRead,
+		Update: notificationProfileUpdate,
+		Delete: notificationProfileDelete,
+		Exists: notificationProfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: NotificationProfileSchema,
+	}
+}
+
+func notificationProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	notificationProfile := resourceDataToNotificationProfile(d)
+
+	notificationProfile, err := client.NotificationProfiles().Create(notificationProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(notificationProfile.ProfileID)
+
+	return nil
+}
+
+func notificationProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	notificationProfile, err := client.NotificationProfiles().Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateNotificationProfileResourceData(d, notificationProfile)
+
+	return nil
+}
+
+func notificationProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	notificationProfile := resourceDataToNotificationProfile(d)
+
+	notificationProfile, err := client.NotificationProfiles().Update(notificationProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(notificationProfile.ProfileID)
+
+	return nil
+}
+
+func notificationProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	err := client.NotificationProfiles().Delete(d.Id())
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func notificationProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(site24x7.Client)
+
+	_, err := client.NotificationProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func resourceDataToNotificationProfile(d *schema.ResourceData) *api.NotificationProfile {
+	var escalationAutomations []string
+	for _, id := range d.Get("escalation_automations").([]interface{}) {
+		escalationAutomations = append(escalationAutomations, id.(string))
+	}
+
+	var escalationServices []string
+	for _, id := range d.Get("escalation_services").([]interface{}) {
+		escalationServices = append(escalationServices, id.(string))
+	}
+
+	return &api.NotificationProfile{
+		ProfileID:                   d.Id(),
+		ProfileName:                 d.Get("profile_name").(string),
+		RcaNeeded:                   d.Get("rca_needed").(bool),
+		NotifyAfterExecutingActions: d.Get("notify_after_executing_actions").(bool),
+		DowntimeNotificationDelay:   d.Get("downtime_notification_delay").(int),
+		PersistentNotification:      d.Get("persistent_notification").(int),
+		EscalationUserGroupId:       d.Get("escalation_user_group_id").(string),
+		EscalationWaitTime:          d.Get("escalation_wait_time").(int),
+		EscalationAutomations:       escalationAutomations,
+		EscalationServices:          escalationServices,
+		TemplateID:                  d.Get("template_id").(string),
+	}
+}
+
+//nolint:errcheck
+func updateNotificationProfileResourceData(d *schema.ResourceData, notificationProfile *api.NotificationProfile) {
+	d.Set("profile_name", notificationProfile.ProfileName)
+	d.Set("rca_needed", notificationProfile.RcaNeeded)
+	d.Set("notify_after_executing_actions", notificationProfile.NotifyAfterExecutingActions)
+	d.Set("downtime_notification_delay", notificationProfile.DowntimeNotificationDelay)
+	d.Set("persistent_notification", notificationProfile.PersistentNotification)
+	d.Set("escalation_user_group_id", notificationProfile.EscalationUserGroupId)
+	d.Set("escalation_wait_time", notificationProfile.EscalationWaitTime)
+	d.Set("escalation_automations", notificationProfile.EscalationAutomations)
+	d.Set("escalation_services", notificationProfile.EscalationServices)
+	d.Set("template_id", notificationProfile.TemplateID)
+}
@@ -0,0 +1,97 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var NotificationProfileDataSourceSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"rca_needed": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"notify_after_executing_actions": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"downtime_notification_delay": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"persistent_notification": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"escalation_user_group_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"escalation_wait_time": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"escalation_automations": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"escalation_services": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"template_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7NotificationProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: notificationProfileDataSourceRead,
+
+		Schema: NotificationProfileDataSourceSchema,
+	}
+}
+
+func notificationProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	notificationProfiles, err := client.NotificationProfiles().List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(notificationProfiles))
+	for i, notificationProfile := range notificationProfiles {
+		names[i] = notificationProfile.ProfileName
+	}
+
+	i, err := findByName(d, "profile_name", "notification profile", names)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(notificationProfiles[i].ProfileID)
+
+	updateNotificationProfileResourceData(d, notificationProfiles[i])
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package site24x7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationProfileDataSourceRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, NotificationProfileDataSourceSchema, map[string]interface{}{
+		"profile_name": "foo",
+	})
+
+	c := fake.NewClient()
+
+	c.FakeNotificationProfiles.On("List").Return([]*api.NotificationProfile{
+		{
+			ProfileID:   "123",
+			ProfileName: "foobar",
+		},
+		{
+			ProfileID:                 "456",
+			ProfileName:               "foo",
+			RcaNeeded:                 true,
+			DowntimeNotificationDelay: 2,
+			EscalationUserGroupId:     "789",
+			EscalationServices:        []string{"012"},
+		},
+	}, nil).Once()
+
+	require.NoError(t, notificationProfileDataSourceRead(d, c))
+
+	assert.Equal(t, "456", d.Id())
+	assert.True(t, d.Get("rca_needed").(bool))
+	assert.Equal(t, 2, d.Get("downtime_notification_delay"))
+	assert.Equal(t, "789", d.Get("escalation_user_group_id"))
+	assert.Equal(t, []interface{}{"012"}, d.Get("escalation_services"))
+
+	c.FakeNotificationProfiles.On("List").Return([]*api.NotificationProfile{}, nil).Once()
+
+	err := notificationProfileDataSourceRead(d, c)
+
+	assert.Equal(t, errors.New(`no notification profile found with profile_name "foo"`), err)
+
+	c.FakeNotificationProfiles.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err = notificationProfileDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
@@ -0,0 +1,157 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationProfileCreate(t *testing.T) {
+	d := notificationProfileTestResourceData(t)
+
+	c := fake.NewClient()
+
+	a := &api.NotificationProfile{
+		ProfileName:                 "foobar",
+		RcaNeeded:                   true,
+		NotifyAfterExecutingActions: false,
+		DowntimeNotificationDelay:   2,
+		PersistentNotification:      1,
+		EscalationUserGroupId:       "456",
+		EscalationWaitTime:          30,
+		EscalationAutomations:       []string{"789"},
+		EscalationServices:          []string{"012"},
+	}
+
+	c.FakeNotificationProfiles.On("Create", a).Return(a, nil).Once()
+
+	require.NoError(t, notificationProfileCreate(d, c))
+
+	c.FakeNotificationProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := notificationProfileCreate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestNotificationProfileUpdate(t *testing.T) {
+	d := notificationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	a := &api.NotificationProfile{
+		ProfileID:                   "123",
+		ProfileName:                 "foobar",
+		RcaNeeded:                   true,
+		NotifyAfterExecutingActions: false,
+		DowntimeNotificationDelay:   2,
+		PersistentNotification:      1,
+		EscalationUserGroupId:       "456",
+		EscalationWaitTime:          30,
+		EscalationAutomations:       []string{"789"},
+		EscalationServices:          []string{"012"},
+	}
+
+	c.FakeNotificationProfiles.On("Update", a).Return(a, nil).Once()
+
+	require.NoError(t, notificationProfileUpdate(d, c))
+
+	c.FakeNotificationProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := notificationProfileUpdate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestNotificationProfileRead(t *testing.T) {
+	d := notificationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeNotificationProfiles.On("Get", "123").Return(&api.NotificationProfile{
+		ProfileID:                 "123",
+		ProfileName:               "baz",
+		RcaNeeded:                 false,
+		DowntimeNotificationDelay: 5,
+		EscalationUserGroupId:     "654",
+		TemplateID:                "987",
+	}, nil).Once()
+
+	require.NoError(t, notificationProfileRead(d, c))
+
+	assert.Equal(t, "baz", d.Get("profile_name"))
+	assert.False(t, d.Get("rca_needed").(bool))
+	assert.Equal(t, 5, d.Get("downtime_notification_delay"))
+	assert.Equal(t, "654", d.Get("escalation_user_group_id"))
+	assert.Equal(t, "987", d.Get("template_id"))
+
+	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := notificationProfileRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestNotificationProfileDelete(t *testing.T) {
+	d := notificationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeNotificationProfiles.On("Delete", "123").Return(nil).Once()
+
+	require.NoError(t, notificationProfileDelete(d, c))
+
+	c.FakeNotificationProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
+
+	require.NoError(t, notificationProfileDelete(d, c))
+}
+
+func TestNotificationProfileExists(t *testing.T) {
+	d := notificationProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeNotificationProfiles.On("Get", "123").Return(&api.NotificationProfile{}, nil).Once()
+
+	exists, err := notificationProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
+
+	exists, err = notificationProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	c.FakeNotificationProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	exists, err = notificationProfileExists(d, c)
+
+	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.False(t, exists)
+}
+
+func notificationProfileTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, NotificationProfileSchema, map[string]interface{}{
+		"profile_name":                   "foobar",
+		"rca_needed":                     true,
+		"notify_after_executing_actions": false,
+		"downtime_notification_delay":    2,
+		"persistent_notification":        1,
+		"escalation_user_group_id":       "456",
+		"escalation_wait_time":           30,
+		"escalation_automations":         []interface{}{"789"},
+		"escalation_services":            []interface{}{"012"},
+	})
+}
@@ -63,9 +63,24 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"site24x7_website_monitor": resourceSite24x7WebsiteMonitor(),
-			"site24x7_monitor_group":   resourceSite24x7MonitorGroup(),
-			"site24x7_action":          resourceSite24x7Action(),
+			"site24x7_website_monitor":      resourceSite24x7WebsiteMonitor(),
+			"site24x7_monitor_group":        resourceSite24x7MonitorGroup(),
+			"site24x7_action":               resourceSite24x7Action(),
+			"site24x7_location_profile":     resourceSite24x7LocationProfile(),
+			"site24x7_notification_profile": resourceSite24x7NotificationProfile(),
+			"site24x7_threshold_profile":    resourceSite24x7ThresholdProfile(),
+			"site24x7_user_group":           resourceSite24x7UserGroup(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"site24x7_location_profile":     dataSourceSite24x7LocationProfile(),
+			"site24x7_monitor":              dataSourceSite24x7Monitor(),
+			"site24x7_monitors":             dataSourceSite24x7Monitors(),
+			"site24x7_monitor_group":        dataSourceSite24x7MonitorGroup(),
+			"site24x7_monitor_locations":    dataSourceSite24x7MonitorLocations(),
+			"site24x7_notification_profile": dataSourceSite24x7NotificationProfile(),
+			"site24x7_threshold_profile":    dataSourceSite24x7ThresholdProfile(),
+			"site24x7_user_group":           dataSourceSite24x7UserGroup(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -0,0 +1,184 @@
+package site24x7
+
+import (
+	"fmt"
+
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// thresholdProfileTypes are the monitor types threshold profiles can be
+// created for.
+var thresholdProfileTypes = []string{
+	"URL",
+	"HOMEPAGE",
+	"REALBROWSER",
+	"RESTAPI",
+	"SOAP",
+	"DNS",
+	"SSL_CERT",
+	"PING",
+	"PORT",
+	"FTP",
+	"SMTP",
+	"POP",
+	"IMAP",
+	"HEARTBEAT",
+	"DOMAINEXPIRY",
+}
+
+var ThresholdProfileSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringInSlice(thresholdProfileTypes, false),
+	},
+	"down_location_threshold": {
+		Type:     schema.TypeInt,
+		Optional: true,
+		Default:  1,
+	},
+	"website_content_modified": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	},
+}
+
+func resourceSite24x7ThresholdProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: thresholdProfileCreate,
+		Read:   thresholdProfileRead,
+		Update: thresholdProfileUpdate,
+		Delete: thresholdProfileDelete,
+		Exists: thresholdProfileExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: thresholdProfileCustomizeDiff,
+
+		Schema: ThresholdProfileSchema,
+	}
+}
+
+func thresholdProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	thresholdProfile := resourceDataToThresholdProfile(d)
+
+	thresholdProfile, err := client.ThresholdProfiles().Create(thresholdProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(thresholdProfile.ProfileID)
+
+	return nil
+}
+
+func thresholdProfileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	thresholdProfile, err := client.ThresholdProfiles().Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateThresholdProfileResourceData(d, thresholdProfile)
+
+	return nil
+}
+
+func thresholdProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	thresholdProfile := resourceDataToThresholdProfile(d)
+
+	thresholdProfile, err := client.ThresholdProfiles().Update(thresholdProfile)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(thresholdProfile.ProfileID)
+
+	return nil
+}
+
+func thresholdProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	err := client.ThresholdProfiles().Delete(d.Id())
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func thresholdProfileExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(site24x7.Client)
+
+	_, err := client.ThresholdProfiles().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func thresholdProfileCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return validateThresholdProfileAttributes(
+		d.Get("type").(string),
+		d.NewValueKnown("type"),
+		d.Get("website_content_modified").(bool),
+	)
+}
+
+// validateThresholdProfileAttributes returns an error if threshold attributes
+// are set which are not supported by the threshold profile's monitor type.
+// Validation is skipped if the type is not known yet, e.g. because it is
+// interpolated from another resource. The diff is customized again once the
+// value is known.
+//
+// down_location_threshold is not validated against the type.
+func validateThresholdProfileAttributes(profileType string, profileTypeKnown bool, websiteContentModified bool) error {
+	if !profileTypeKnown {
+		return nil
+	}
+
+	if websiteContentModified && profileType != "URL" {
+		return fmt.Errorf("website_content_modified is only supported for threshold profiles of type URL, got %q", profileType)
+	}
+
+	return nil
+}
+
+func resourceDataToThresholdProfile(d *schema.ResourceData) *api.ThresholdProfile {
+	return &api.ThresholdProfile{
+		ProfileID:              d.Id(),
+		Type:                   d.Get("type").(string),
+		ProfileName:            d.Get("profile_name").(string),
+		DownLocationThreshold:  d.Get("down_location_threshold").(int),
+		WebsiteContentModified: d.Get("website_content_modified").(bool),
+	}
+}
+
+func updateThresholdProfileResourceData(d *schema.ResourceData, thresholdProfile *api.ThresholdProfile) {
+	d.Set("profile_name", thresholdProfile.ProfileName)                        //nolint:errcheck
+	d.Set("type", thresholdProfile.Type)                                       //nolint:errcheck
+	d.Set("down_location_threshold", thresholdProfile.DownLocationThreshold)   //nolint:errcheck
+	d.Set("website_content_modified", thresholdProfile.WebsiteContentModified) //nolint:errcheck
+}
@@ -0,0 +1,67 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var ThresholdProfileDataSourceSchema = map[string]*schema.Schema{
+	"profile_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"profile_name", "name_regex"},
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"down_location_threshold": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"website_content_modified": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7ThresholdProfile() *schema.Resource {
+	return &schema.Resource{
+		Read: thresholdProfileDataSourceRead,
+
+		Schema: ThresholdProfileDataSourceSchema,
+	}
+}
+
+func thresholdProfileDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	thresholdProfiles, err := client.ThresholdProfiles().List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(thresholdProfiles))
+	for i, thresholdProfile := range thresholdProfiles {
+		names[i] = thresholdProfile.ProfileName
+	}
+
+	i, err := findByName(d, "profile_name", "threshold profile", names)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(thresholdProfiles[i].ProfileID)
+
+	updateThresholdProfileResourceData(d, thresholdProfiles[i])
+
+	return nil
+}
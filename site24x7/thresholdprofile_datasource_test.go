@@ -0,0 +1,51 @@
+package site24x7
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdProfileDataSourceRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ThresholdProfileDataSourceSchema, map[string]interface{}{
+		"profile_name": "foo",
+	})
+
+	c := fake.NewClient()
+
+	c.FakeThresholdProfiles.On("List").Return([]*api.ThresholdProfile{
+		{
+			ProfileID:             "123",
+			ProfileName:           "foo",
+			Type:                  "URL",
+			DownLocationThreshold: 2,
+		},
+	}, nil).Once()
+
+	require.NoError(t, thresholdProfileDataSourceRead(d, c))
+
+	assert.Equal(t, "123", d.Id())
+	assert.Equal(t, "URL", d.Get("type"))
+	assert.Equal(t, 2, d.Get("down_location_threshold"))
+
+	c.FakeThresholdProfiles.On("List").Return([]*api.ThresholdProfile{
+		{ProfileID: "123", ProfileName: "foo"},
+		{ProfileID: "456", ProfileName: "foo"},
+	}, nil).Once()
+
+	err := thresholdProfileDataSourceRead(d, c)
+
+	assert.Equal(t, errors.New(`found 2 threshold profiles with profile_name "foo", expected exactly one`), err)
+
+	c.FakeThresholdProfiles.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err = thresholdProfileDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
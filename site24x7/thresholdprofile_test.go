@@ -0,0 +1,148 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdProfileCreate(t *testing.T) {
+	d := thresholdProfileTestResourceData(t)
+
+	c := fake.NewClient()
+
+	a := &api.ThresholdProfile{
+		ProfileName:            "foobar",
+		Type:                   "URL",
+		DownLocationThreshold:  2,
+		WebsiteContentModified: true,
+	}
+
+	c.FakeThresholdProfiles.On("Create", a).Return(a, nil).Once()
+
+	require.NoError(t, thresholdProfileCreate(d, c))
+
+	c.FakeThresholdProfiles.On("Create", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := thresholdProfileCreate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestThresholdProfileUpdate(t *testing.T) {
+	d := thresholdProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	a := &api.ThresholdProfile{
+		ProfileID:              "123",
+		ProfileName:            "foobar",
+		Type:                   "URL",
+		DownLocationThreshold:  2,
+		WebsiteContentModified: true,
+	}
+
+	c.FakeThresholdProfiles.On("Update", a).Return(a, nil).Once()
+
+	require.NoError(t, thresholdProfileUpdate(d, c))
+
+	c.FakeThresholdProfiles.On("Update", a).Return(a, apierrors.NewStatusError(500, "error")).Once()
+
+	err := thresholdProfileUpdate(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestThresholdProfileRead(t *testing.T) {
+	d := thresholdProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeThresholdProfiles.On("Get", "123").Return(&api.ThresholdProfile{
+		ProfileID:             "123",
+		ProfileName:           "baz",
+		Type:                  "URL",
+		DownLocationThreshold: 3,
+	}, nil).Once()
+
+	require.NoError(t, thresholdProfileRead(d, c))
+
+	assert.Equal(t, "baz", d.Get("profile_name"))
+	assert.Equal(t, 3, d.Get("down_location_threshold"))
+	assert.False(t, d.Get("website_content_modified").(bool))
+
+	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := thresholdProfileRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
+
+func TestThresholdProfileDelete(t *testing.T) {
+	d := thresholdProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeThresholdProfiles.On("Delete", "123").Return(nil).Once()
+
+	require.NoError(t, thresholdProfileDelete(d, c))
+
+	c.FakeThresholdProfiles.On("Delete", "123").Return(apierrors.NewStatusError(404, "not found")).Once()
+
+	require.NoError(t, thresholdProfileDelete(d, c))
+}
+
+func TestThresholdProfileExists(t *testing.T) {
+	d := thresholdProfileTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeThresholdProfiles.On("Get", "123").Return(&api.ThresholdProfile{}, nil).Once()
+
+	exists, err := thresholdProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(404, "not found")).Once()
+
+	exists, err = thresholdProfileExists(d, c)
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	c.FakeThresholdProfiles.On("Get", "123").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	exists, err = thresholdProfileExists(d, c)
+
+	require.Equal(t, apierrors.NewStatusError(500, "error"), err)
+	assert.False(t, exists)
+}
+
+func TestValidateThresholdProfileAttributes(t *testing.T) {
+	require.NoError(t, validateThresholdProfileAttributes("URL", true, true))
+	require.NoError(t, validateThresholdProfileAttributes("SSL_CERT", true, false))
+	require.NoError(t, validateThresholdProfileAttributes("", false, true))
+
+	err := validateThresholdProfileAttributes("SSL_CERT", true, true)
+
+	assert.EqualError(t, err, `website_content_modified is only supported for threshold profiles of type URL, got "SSL_CERT"`)
+}
+
+func thresholdProfileTestResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ThresholdProfileSchema, map[string]interface{}{
+		"profile_name":             "foobar",
+		"type":                     "URL",
+		"down_location_threshold":  2,
+		"website_content_modified": true,
+	})
+}
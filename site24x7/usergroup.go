@@ -0,0 +1,130 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var UserGroupSchema = map[string]*schema.Schema{
+	"display_name": {
+		Type:     schema.TypeString,
+		Required: true,
+	},
+	"users": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Required: true,
+	},
+	"attribute_group_id": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
+func resourceSite24x7UserGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: userGroupCreate,
+		Read:   userGroupRead,
+		Update: userGroupUpdate,
+		Delete: userGroupDelete,
+		Exists: userGroupExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: UserGroupSchema,
+	}
+}
+
+func userGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	userGroup := resourceDataToUserGroup(d)
+
+	userGroup, err := client.UserGroups().Create(userGroup)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(userGroup.UserGroupID)
+
+	return nil
+}
+
+func userGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	userGroup, err := client.UserGroups().Get(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateUserGroupResourceData(d, userGroup)
+
+	return nil
+}
+
+func userGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	userGroup := resourceDataToUserGroup(d)
+
+	userGroup, err := client.UserGroups().Update(userGroup)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(userGroup.UserGroupID)
+
+	return nil
+}
+
+func userGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	err := client.UserGroups().Delete(d.Id())
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func userGroupExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(site24x7.Client)
+
+	_, err := client.UserGroups().Get(d.Id())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func resourceDataToUserGroup(d *schema.ResourceData) *api.UserGroup {
+	var users []string
+	for _, id := range d.Get("users").([]interface{}) {
+		users = append(users, id.(string))
+	}
+
+	return &api.UserGroup{
+		UserGroupID:      d.Id(),
+		DisplayName:      d.Get("display_name").(string),
+		Users:            users,
+		AttributeGroupID: d.Get("attribute_group_id").(string),
+	}
+}
+
+func updateUserGroupResourceData(d *schema.ResourceData, userGroup *api.UserGroup) {
+	d.Set("display_name", userGroup.DisplayName)            //nolint:errcheck
+	d.Set("users", userGroup.Users)                         //nolint:errcheck
+	d.Set("attribute_group_id", userGroup.AttributeGroupID) //nolint:errcheck
+}
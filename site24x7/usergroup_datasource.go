@@ -0,0 +1,66 @@
+package site24x7
+
+import (
+	site24x7 "github.com/Bonial-International-GmbH/site24x7-go"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var UserGroupDataSourceSchema = map[string]*schema.Schema{
+	"display_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"display_name", "name_regex"},
+	},
+	"name_regex": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		ExactlyOneOf: []string{"display_name", "name_regex"},
+	},
+	"users": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Computed: true,
+	},
+	"attribute_group_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func dataSourceSite24x7UserGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: userGroupDataSourceRead,
+
+		Schema: UserGroupDataSourceSchema,
+	}
+}
+
+func userGroupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(site24x7.Client)
+
+	userGroups, err := client.UserGroups().List()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, len(userGroups))
+	for i, userGroup := range userGroups {
+		names[i] = userGroup.DisplayName
+	}
+
+	i, err := findByName(d, "display_name", "user group", names)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(userGroups[i].UserGroupID)
+
+	updateUserGroupResourceData(d, userGroups[i])
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package site24x7
+
+import (
+	"testing"
+
+	"github.com/Bonial-International-GmbH/site24x7-go/api"
+	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
+	"github.com/Bonial-International-GmbH/site24x7-go/fake"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserGroupDataSourceRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, UserGroupDataSourceSchema, map[string]interface{}{
+		"display_name": "ops",
+	})
+
+	c := fake.NewClient()
+
+	c.FakeUserGroups.On("List").Return([]*api.UserGroup{
+		{
+			UserGroupID: "123",
+			DisplayName: "dev",
+		},
+		{
+			UserGroupID:      "456",
+			DisplayName:      "ops",
+			Users:            []string{"789"},
+			AttributeGroupID: "012",
+		},
+	}, nil).Once()
+
+	require.NoError(t, userGroupDataSourceRead(d, c))
+
+	assert.Equal(t, "456", d.Id())
+	assert.Equal(t, []interface{}{"789"}, d.Get("users"))
+	assert.Equal(t, "012", d.Get("attribute_group_id"))
+
+	c.FakeUserGroups.On("List").Return(nil, apierrors.NewStatusError(500, "error")).Once()
+
+	err := userGroupDataSourceRead(d, c)
+
+	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Bonial-International-GmbH/site24x7-go/api"
 	apierrors "github.com/Bonial-International-GmbH/site24x7-go/api/errors"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	log "github.com/sirupsen/logrus"
 )
 
 var WebsiteMonitorSchema = map[string]*schema.Schema{
@@ -356,7 +357,11 @@ func updateWebsiteMonitorResourceData(d *schema.ResourceData, monitor *api.Monit
 	d.Set("display_name", monitor.DisplayName)
 	d.Set("type", monitor.Type)
 	d.Set("website", monitor.Website)
-	d.Set("check_frequency", monitor.CheckFrequency)
+	if checkFrequency, err := strconv.Atoi(monitor.CheckFrequency); err != nil {
+		log.Warnf("failed to parse check_frequency %q of monitor %q: %v", monitor.CheckFrequency, monitor.MonitorID, err)
+	} else {
+		d.Set("check_frequency", checkFrequency)
+	}
 	d.Set("http_method", monitor.HTTPMethod)
 	d.Set("auth_user", monitor.AuthUser)
 	d.Set("auth_pass", monitor.AuthPass)
@@ -292,6 +292,25 @@ func TestWebsiteMonitorRead(t *testing.T) {
 	assert.Equal(t, apierrors.NewStatusError(500, "error"), err)
 }
 
+func TestWebsiteMonitorReadCheckFrequency(t *testing.T) {
+	d := monitorTestResourceData(t)
+	d.SetId("123")
+
+	c := fake.NewClient()
+
+	c.FakeMonitors.On("Get", "123").Return(&api.Monitor{MonitorID: "123", CheckFrequency: "5"}, nil).Once()
+
+	require.NoError(t, websiteMonitorRead(d, c))
+
+	assert.Equal(t, 5, d.Get("check_frequency"))
+
+	c.FakeMonitors.On("Get", "123").Return(&api.Monitor{MonitorID: "123", CheckFrequency: "invalid"}, nil).Once()
+
+	require.NoError(t, websiteMonitorRead(d, c))
+
+	assert.Equal(t, 5, d.Get("check_frequency"))
+}
+
 func TestWebsiteMonitorDelete(t *testing.T) {
 	d := monitorTestResourceData(t)
 	d.SetId("123")